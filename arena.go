@@ -2,7 +2,6 @@ package arena
 
 import (
 	"reflect"
-	"sync"
 	"unsafe"
 )
 
@@ -26,12 +25,22 @@ func WithZeroOnAlloc(z bool) Option {
 	}
 }
 
+// WithChunkPool makes the arena draw new chunks from p (falling back to
+// make when p has none of the requested size) and return its non-first
+// chunks to p on Reset/Release instead of dropping them for the GC.
+func WithChunkPool(p *ChunkPool) Option {
+	return func(a *Arena) {
+		a.pool = p
+	}
+}
+
 type Arena struct {
 	chunkSize   int
 	zeroOnAlloc bool
+	pool        *ChunkPool
 
-	chunks [][]byte 
-	off    int      
+	chunks [][]byte
+	off    int
 }
 
 // New creates a new Arena with optional configuration.
@@ -48,10 +57,24 @@ func New(opts ...Option) *Arena {
 	if a.chunkSize <= 0 {
 		a.chunkSize = defaultChunkSize
 	}
-	a.chunks = append(a.chunks, make([]byte, a.chunkSize))
+	a.chunks = append(a.chunks, a.newChunk(a.chunkSize))
 	return a
 }
 
+// newChunk returns a chunk of sz bytes, preferring the arena's pool (if
+// any) over a fresh make.
+func (a *Arena) newChunk(sz int) []byte {
+	if a.pool != nil {
+		if buf := a.pool.get(sz); buf != nil {
+			if a.zeroOnAlloc {
+				zero(buf)
+			}
+			return buf
+		}
+	}
+	return make([]byte, sz)
+}
+
 func (a *Arena) Alloc(n int) []byte {
 	return a.AllocAligned(n, 8)
 }
@@ -88,7 +111,7 @@ func (a *Arena) AllocAligned(n int, align int) []byte {
 	if n+align > newSize {
 		newSize = n + align
 	}
-	buf := make([]byte, newSize)
+	buf := a.newChunk(newSize)
 	a.chunks = append(a.chunks, buf)
 	off = 0
 	pad = (align - (off & (align - 1))) & (align - 1)
@@ -102,9 +125,9 @@ func (a *Arena) AllocAligned(n int, align int) []byte {
 	return res
 }
 
-// AllocValue allocates space for a typed value of type T inside the arena and returns *T.
+// AllocValue allocates space for a typed value of type T inside a and returns *T.
 // T must not contain pointers (POD). If T contains pointers, AllocValue will panic.
-func (a *Arena) AllocValue[T any]() *T {
+func AllocValue[T any](a *Arena) *T {
 	var zeroT *T
 	typ := reflect.TypeOf(zeroT).Elem()
 	if containsPointers(typ) {
@@ -121,9 +144,60 @@ func (a *Arena) AllocValue[T any]() *T {
 	return (*T)(unsafe.Pointer(&mem[0]))
 }
 
+// Marker is an opaque savepoint captured by Mark and consumed by Restore.
+type Marker struct {
+	chunks int
+	off    int
+}
+
+// Mark captures the arena's current allocation position. Restore(m)
+// rewinds to that position, reclaiming everything allocated since.
+func (a *Arena) Mark() Marker {
+	return Marker{chunks: len(a.chunks), off: a.off}
+}
+
+// Restore rewinds the arena to the position captured by m: the bump
+// offset is reset and any chunks allocated after the mark are dropped.
+// If zeroOnAlloc is set, the reclaimed range of the chunk live at m is
+// zeroed so the next allocation doesn't observe stale contents.
+// Restore panics if m was not taken from this arena's current lifetime
+// (i.e. refers to more chunks than the arena currently has).
+func (a *Arena) Restore(m Marker) {
+	if m.chunks <= 0 || m.chunks > len(a.chunks) {
+		panic("arena: Restore called with a Marker from a different arena lifetime")
+	}
+	sameChunk := m.chunks == len(a.chunks)
+	markChunkLen := len(a.chunks[m.chunks-1])
+	for i := m.chunks; i < len(a.chunks); i++ {
+		if a.pool != nil {
+			a.pool.put(a.chunks[i])
+		}
+		a.chunks[i] = nil
+	}
+	a.chunks = a.chunks[:m.chunks]
+	if a.zeroOnAlloc {
+		end := markChunkLen
+		if sameChunk {
+			end = a.off
+		}
+		zero(a.chunks[m.chunks-1][m.off:end])
+	}
+	a.off = m.off
+}
+
+// Scope marks the arena, invokes fn, and restores to the mark when fn
+// returns (including when fn panics), reclaiming everything fn allocated.
+// It gives phase-structured allocation without the all-or-nothing
+// semantics of Reset.
+func (a *Arena) Scope(fn func(*Arena)) {
+	m := a.Mark()
+	defer a.Restore(m)
+	fn(a)
+}
+
 func (a *Arena) Reset() {
 	if len(a.chunks) == 0 {
-		a.chunks = append(a.chunks, make([]byte, a.chunkSize))
+		a.chunks = append(a.chunks, a.newChunk(a.chunkSize))
 		a.off = 0
 		return
 	}
@@ -131,8 +205,11 @@ func (a *Arena) Reset() {
 	if a.zeroOnAlloc && a.off > 0 {
 		zero(a.chunks[0][:a.off])
 	}
-	// drop other chunks so they can be GC'd
+	// return the other chunks to the pool (if any) instead of dropping them for the GC
 	for i := 1; i < len(a.chunks); i++ {
+		if a.pool != nil {
+			a.pool.put(a.chunks[i])
+		}
 		a.chunks[i] = nil
 	}
 	a.chunks = a.chunks[:1]
@@ -140,9 +217,15 @@ func (a *Arena) Reset() {
 }
 
 func (a *Arena) Release() {
-	for i := range a.chunks {
+	for i := 1; i < len(a.chunks); i++ {
+		if a.pool != nil {
+			a.pool.put(a.chunks[i])
+		}
 		a.chunks[i] = nil
 	}
+	if len(a.chunks) > 0 {
+		a.chunks[0] = nil
+	}
 	a.chunks = nil
 	a.off = 0
 }
@@ -162,93 +245,3 @@ func (a *Arena) Stats() (used int, capacity int) {
 	}
 	return
 }
-
-type ConcurrentArena struct {
-	mu sync.Mutex
-	a  *Arena
-}
-
-func NewConcurrent(opts ...Option) *ConcurrentArena {
-	return &ConcurrentArena{a: New(opts...)}
-}
-
-func (c *ConcurrentArena) Alloc(n int) []byte {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.a.Alloc(n)
-}
-
-func (c *ConcurrentArena) AllocAligned(n int, align int) []byte {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.a.AllocAligned(n, align)
-}
-
-func (c *ConcurrentArena) AllocValue[T any]() *T {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.a.AllocValue[T]()
-}
-
-func (c *ConcurrentArena) Reset() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.a.Reset()
-}
-
-func (c *ConcurrentArena) Release() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.a.Release()
-}
-
-func (c *ConcurrentArena) Stats() (used int, capacity int) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	return c.a.Stats()
-}
-
-// ------------------ helpers ------------------
-
-func zero(b []byte) {
-	for i := range b {
-		b[i] = 0
-	}
-}
-
-// containsPointers returns true if t (recursively) contains any Go pointers
-// (ptr, slice, map, chan, func, interface, string, unsafe.Pointer).
-// It is conservative but prevents unsafe use of AllocValue for pointerful types.
-func containsPointers(t reflect.Type) bool {
-	visited := make(map[reflect.Type]bool)
-	return containsPointersRec(t, visited)
-}
-
-func containsPointersRec(t reflect.Type, visited map[reflect.Type]bool) bool {
-	if t == nil {
-		return false
-	}
-	if visited[t] {
-		return false
-	}
-	visited[t] = true
-
-	switch t.Kind() {
-	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer, reflect.String:
-		return true
-	case reflect.Array:
-		return containsPointersRec(t.Elem(), visited)
-	case reflect.Struct:
-		// check fields
-		for i := 0; i < t.NumField(); i++ {
-			f := t.Field(i)
-			// skip unexported field? No â€” even unexported may contain pointers; check anyway
-			if containsPointersRec(f.Type, visited) {
-				return true
-			}
-		}
-		return false
-	default:
-		return false
-	}
-}
@@ -0,0 +1,65 @@
+package arena
+
+import "testing"
+
+func TestArenaMarkRestore(t *testing.T) {
+	a := New(WithChunkSize(64))
+	a.Alloc(8)
+	m := a.Mark()
+	a.Alloc(32)
+	a.Restore(m)
+
+	if a.off != m.off {
+		t.Fatalf("Restore left off = %d, want %d", a.off, m.off)
+	}
+	p := AllocValue[int](a)
+	*p = 7
+	if *p != 7 {
+		t.Fatalf("AllocValue roundtrip failed after Restore")
+	}
+}
+
+func TestArenaRestoreDropsChunksAllocatedAfterMark(t *testing.T) {
+	a := New(WithChunkSize(16))
+	m := a.Mark()
+	a.Alloc(16)
+	a.Alloc(16) // no room left in the first chunk; forces a second
+	if len(a.chunks) < 2 {
+		t.Fatalf("expected at least 2 chunks before Restore, got %d", len(a.chunks))
+	}
+
+	a.Restore(m)
+	if len(a.chunks) != 1 {
+		t.Fatalf("Restore left %d chunks, want 1", len(a.chunks))
+	}
+}
+
+func TestArenaScopeRestoresOnPanic(t *testing.T) {
+	a := New(WithChunkSize(64))
+	m := a.Mark()
+
+	func() {
+		defer func() { recover() }()
+		a.Scope(func(inner *Arena) {
+			inner.Alloc(8)
+			panic("boom")
+		})
+	}()
+
+	if a.off != m.off {
+		t.Fatalf("Scope left off = %d after panic, want %d", a.off, m.off)
+	}
+}
+
+func TestArenaScopeRestoresOnReturn(t *testing.T) {
+	a := New(WithChunkSize(64))
+	m := a.Mark()
+
+	a.Scope(func(inner *Arena) {
+		inner.Alloc(16)
+	})
+
+	if a.off != m.off {
+		t.Fatalf("Scope left off = %d, want %d", a.off, m.off)
+	}
+}
@@ -0,0 +1,132 @@
+package arena
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ConcurrentArena is an Arena safe for concurrent use from multiple
+// goroutines. Rather than a single mutex wrapping one inner Arena, it
+// shards into a power-of-two number of independent arenas and routes each
+// caller to its own shard by pinning to the calling goroutine's current P
+// (see runtime_procPin), so goroutines running on different Ps normally
+// use different shards. The pin is released before the shard is used, so a
+// goroutine that migrates mid-call can still share a shard with another.
+type ConcurrentArena struct {
+	shards []*concurrentShard
+	mask   int
+}
+
+type concurrentShard struct {
+	mu sync.Mutex
+	a  *Arena
+}
+
+// NewConcurrent creates a ConcurrentArena sharded across
+// runtime.GOMAXPROCS(0) (rounded up to a power of two) inner arenas, each
+// configured with opts.
+func NewConcurrent(opts ...Option) *ConcurrentArena {
+	n := nextPowerOfTwo(runtime.GOMAXPROCS(0))
+	shards := make([]*concurrentShard, n)
+	for i := range shards {
+		shards[i] = &concurrentShard{a: New(opts...)}
+	}
+	return &ConcurrentArena{shards: shards, mask: n - 1}
+}
+
+func nextPowerOfTwo(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
+
+// shardFor returns the shard for the calling goroutine's current P. It
+// pins only long enough to read the P id, so the returned shard must still
+// be accessed through its own mutex.
+func (c *ConcurrentArena) shardFor() *concurrentShard {
+	pid := runtime_procPin()
+	runtime_procUnpin()
+	return c.shards[pid&c.mask]
+}
+
+func (c *ConcurrentArena) Alloc(n int) []byte {
+	s := c.shardFor()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.Alloc(n)
+}
+
+func (c *ConcurrentArena) AllocAligned(n int, align int) []byte {
+	s := c.shardFor()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.AllocAligned(n, align)
+}
+
+func AllocValueConcurrent[T any](c *ConcurrentArena) *T {
+	s := c.shardFor()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return AllocValue[T](s.a)
+}
+
+// AllocLocal allocates n bytes from the shard belonging to the calling
+// goroutine's current P. Pinning only picks the shard cheaply; it does not
+// by itself give exclusive access, because the goroutine can still be
+// preempted and migrated to a different P mid-call while holding the
+// shard's mutex, racing with whoever else then lands on that P. AllocLocal
+// therefore takes the same shard mutex every other accessor does — it is
+// documented as goroutine-bound because it still skips the cross-P
+// contention of routing through a single global lock, not because it
+// skips locking altogether.
+func (c *ConcurrentArena) AllocLocal(n int) []byte {
+	s := c.shardFor()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.Alloc(n)
+}
+
+func (c *ConcurrentArena) Reset() {
+	for _, s := range c.shards {
+		s.mu.Lock()
+	}
+	defer func() {
+		for _, s := range c.shards {
+			s.mu.Unlock()
+		}
+	}()
+	for _, s := range c.shards {
+		s.a.Reset()
+	}
+}
+
+func (c *ConcurrentArena) Release() {
+	for _, s := range c.shards {
+		s.mu.Lock()
+	}
+	defer func() {
+		for _, s := range c.shards {
+			s.mu.Unlock()
+		}
+	}()
+	for _, s := range c.shards {
+		s.a.Release()
+	}
+}
+
+// Stats aggregates used and capacity bytes across every shard.
+func (c *ConcurrentArena) Stats() (used int, capacity int) {
+	for _, s := range c.shards {
+		s.mu.Lock()
+		u, cap := s.a.Stats()
+		s.mu.Unlock()
+		used += u
+		capacity += cap
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package arena
+
+import "testing"
+
+func TestChunkPoolReusedOnNewArena(t *testing.T) {
+	pool := NewChunkPool()
+	a := New(WithChunkSize(64), WithChunkPool(pool))
+	a.Alloc(64) // fills the first chunk exactly
+	a.Alloc(1)  // forces growth into a second 64-byte chunk
+	a.Release()
+
+	if got := pool.Stats()[64]; got != 1 {
+		t.Fatalf("expected 1 pooled 64-byte chunk after Release, got %d", got)
+	}
+
+	b := New(WithChunkSize(64), WithChunkPool(pool))
+	if got := pool.Stats()[64]; got != 0 {
+		t.Fatalf("expected New to pop the pooled chunk, pool still has %d", got)
+	}
+	if used, cap := b.Stats(); used != 0 || cap != 64 {
+		t.Fatalf("b.Stats() = (%d, %d), want (0, 64)", used, cap)
+	}
+}
+
+func TestChunkPoolReset(t *testing.T) {
+	pool := NewChunkPool()
+	a := New(WithChunkSize(32), WithChunkPool(pool))
+	a.Alloc(32)
+	a.Alloc(1) // second chunk
+	a.Reset()
+
+	if got := pool.Stats()[32]; got != 1 {
+		t.Fatalf("expected Reset to return 1 chunk to the pool, got %d", got)
+	}
+}
+
+func TestChunkPoolRestore(t *testing.T) {
+	pool := NewChunkPool()
+	a := New(WithChunkSize(64), WithChunkPool(pool))
+	m := a.Mark()
+	a.Alloc(64)
+	a.Alloc(1) // forces a second 64-byte chunk, allocated after the mark
+
+	a.Restore(m)
+	if got := pool.Stats()[64]; got != 1 {
+		t.Fatalf("expected Restore to return the post-mark chunk to the pool, got %d", got)
+	}
+}
+
+func TestChunkPoolTrim(t *testing.T) {
+	pool := NewChunkPool()
+	for i := 0; i < 5; i++ {
+		pool.put(make([]byte, 32))
+	}
+	pool.Trim(2)
+	if got := pool.Stats()[32]; got != 2 {
+		t.Fatalf("Stats()[32] after Trim(2) = %d, want 2", got)
+	}
+}
@@ -0,0 +1,15 @@
+package arena
+
+import _ "unsafe" // for go:linkname
+
+// runtime_procPin pins the calling goroutine to its current P, returning
+// the P's id, and disables preemption until runtime_procUnpin is called.
+// While pinned, the calling goroutine has exclusive use of whatever is
+// keyed by that P id, with no lock required — the same trick sync.Pool
+// uses internally for its per-P caches.
+//
+//go:linkname runtime_procPin sync.runtime_procPin
+func runtime_procPin() int
+
+//go:linkname runtime_procUnpin sync.runtime_procUnpin
+func runtime_procUnpin()
@@ -0,0 +1,261 @@
+package arena
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// defaultQuarantineGrace is the number of Collect() cycles a freed chunk
+// waits in quarantine before its address space is returned to the OS.
+const defaultQuarantineGrace = 1
+
+// SafeOption configures a SafeArena on creation.
+type SafeOption func(*SafeArena)
+
+// WithSafeChunkSize sets the arena's backing chunk size (must be > 0).
+func WithSafeChunkSize(sz int) SafeOption {
+	return func(a *SafeArena) {
+		if sz > 0 {
+			a.chunkSize = sz
+		}
+	}
+}
+
+// WithSafeZeroOnAlloc controls whether returned memory is zeroed before use.
+func WithSafeZeroOnAlloc(z bool) SafeOption {
+	return func(a *SafeArena) {
+		a.zeroOnAlloc = z
+	}
+}
+
+// WithQuarantineGrace sets how many Collect() calls a freed chunk survives
+// in quarantine before it is unmapped and its address space can be reused
+// by the OS. A grace of 0 unmaps chunks on the very first Collect() after
+// Free(); the default is 1.
+func WithQuarantineGrace(cycles int) SafeOption {
+	return func(a *SafeArena) {
+		if cycles >= 0 {
+			a.quarantineGrace = cycles
+		}
+	}
+}
+
+type safeChunk struct {
+	buf []byte
+	age int
+}
+
+// SafeArena is an Arena whose chunks are backed by OS-mapped memory rather
+// than the Go heap. Free() poisons the arena's address range with
+// PROT_NONE so any surviving pointer into it faults deterministically on
+// next use instead of silently reading or corrupting recycled memory.
+// Freed chunks sit in a quarantine list — see WithQuarantineGrace — before
+// their address space is actually returned to the OS via Collect().
+type SafeArena struct {
+	mu              sync.Mutex
+	chunkSize       int
+	zeroOnAlloc     bool
+	quarantineGrace int
+
+	chunks     []*safeChunk
+	off        int
+	freed      bool
+	quarantine []*safeChunk
+}
+
+// NewSafe creates a new SafeArena with optional configuration.
+func NewSafe(opts ...SafeOption) *SafeArena {
+	a := &SafeArena{
+		chunkSize:       defaultChunkSize,
+		zeroOnAlloc:     true,
+		quarantineGrace: defaultQuarantineGrace,
+	}
+	for _, o := range opts {
+		o(a)
+	}
+	if a.chunkSize <= 0 {
+		a.chunkSize = defaultChunkSize
+	}
+	buf, err := mmapAnon(a.chunkSize)
+	if err != nil {
+		panic("arena: mmap failed: " + err.Error())
+	}
+	a.chunks = append(a.chunks, &safeChunk{buf: buf})
+	return a
+}
+
+// Ref returns a handle to a that can be passed around independently; calls
+// made through it after Free panic, just like calls made directly on a.
+func (a *SafeArena) Ref() ArenaRef {
+	return ArenaRef{a: a}
+}
+
+func (a *SafeArena) alloc(n int, align int) []byte {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.freed {
+		panic("arena: Alloc called on a SafeArena after Free")
+	}
+	if n <= 0 {
+		return nil
+	}
+	if align <= 0 {
+		align = 8
+	}
+	if (align & (align - 1)) != 0 {
+		align = 8
+	}
+
+	last := a.chunks[len(a.chunks)-1]
+	off := a.off
+	pad := (align - (off & (align - 1))) & (align - 1)
+	off += pad
+
+	if off+n <= len(last.buf) {
+		res := last.buf[off : off+n]
+		if a.zeroOnAlloc {
+			zero(res)
+		}
+		a.off = off + n
+		return res
+	}
+
+	newSize := a.chunkSize
+	if n+align > newSize {
+		newSize = n + align
+	}
+	buf, err := mmapAnon(newSize)
+	if err != nil {
+		panic("arena: mmap failed: " + err.Error())
+	}
+	a.chunks = append(a.chunks, &safeChunk{buf: buf})
+	off = 0
+	pad = (align - (off & (align - 1))) & (align - 1)
+	off += pad
+	res := buf[off : off+n]
+	a.off = off + n
+	return res
+}
+
+func (a *SafeArena) Alloc(n int) []byte {
+	return a.alloc(n, 8)
+}
+
+func (a *SafeArena) AllocAligned(n int, align int) []byte {
+	return a.alloc(n, align)
+}
+
+// AllocValueSafe allocates space for a typed value of type T inside a and
+// returns *T. T must not contain pointers (POD); see containsPointers.
+func AllocValueSafe[T any](a *SafeArena) *T {
+	a.mu.Lock()
+	freed := a.freed
+	a.mu.Unlock()
+	if freed {
+		panic("arena: AllocValue called on a SafeArena after Free")
+	}
+
+	var zeroT *T
+	typ := reflect.TypeOf(zeroT).Elem()
+	if containsPointers(typ) {
+		panic("arena: AllocValue called for a type that contains Go pointers; allocate with new(T) instead")
+	}
+	sz := int(typ.Size())
+	if sz == 0 {
+		b := a.Alloc(1)
+		return (*T)(unsafe.Pointer(&b[0]))
+	}
+	align := typ.Align()
+	mem := a.AllocAligned(sz, align)
+	return (*T)(unsafe.Pointer(&mem[0]))
+}
+
+// Free poisons every chunk currently owned by the arena with PROT_NONE and
+// moves them into quarantine. Any pointer still held into the arena will
+// segfault on next access instead of observing recycled memory. Subsequent
+// Alloc/AllocValue calls on a or any ArenaRef derived from it panic.
+func (a *SafeArena) Free() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.freed {
+		return
+	}
+	for _, c := range a.chunks {
+		if err := protectNone(c.buf); err != nil {
+			panic("arena: mprotect failed: " + err.Error())
+		}
+		c.age = 0
+		a.quarantine = append(a.quarantine, c)
+	}
+	a.chunks = nil
+	a.off = 0
+	a.freed = true
+}
+
+// Collect ages the quarantine by one cycle and unmaps (returning to the OS)
+// any chunk that has outlived the configured grace period. Call it
+// periodically (e.g. between requests) to bound how much poisoned address
+// space a long-running process retains.
+func (a *SafeArena) Collect() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	kept := a.quarantine[:0]
+	for _, c := range a.quarantine {
+		c.age++
+		if c.age > a.quarantineGrace {
+			if err := munmapRegion(c.buf); err != nil {
+				panic("arena: munmap failed: " + err.Error())
+			}
+			continue
+		}
+		kept = append(kept, c)
+	}
+	a.quarantine = kept
+}
+
+// Stats reports bytes used and the total size of the arena's live chunks.
+// Chunks moved into quarantine by Free are not counted, even while they
+// are still mapped.
+func (a *SafeArena) Stats() (used int, capacity int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, c := range a.chunks {
+		if i == len(a.chunks)-1 {
+			used += a.off
+		} else {
+			used += len(c.buf)
+		}
+		capacity += len(c.buf)
+	}
+	return
+}
+
+// ArenaRef is a passable handle onto a SafeArena. It carries no allocation
+// state of its own; calling Alloc, AllocAligned or AllocValueRef after the
+// underlying arena's Free panics, the same as calling the arena directly.
+// Free and Collect remain callable after Free.
+type ArenaRef struct {
+	a *SafeArena
+}
+
+func (r ArenaRef) Alloc(n int) []byte {
+	return r.a.Alloc(n)
+}
+
+func (r ArenaRef) AllocAligned(n int, align int) []byte {
+	return r.a.AllocAligned(n, align)
+}
+
+func AllocValueRef[T any](r ArenaRef) *T {
+	return AllocValueSafe[T](r.a)
+}
+
+func (r ArenaRef) Free() {
+	r.a.Free()
+}
+
+func (r ArenaRef) Collect() {
+	r.a.Collect()
+}
@@ -0,0 +1,89 @@
+package arena
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestSafeArenaAllocAndValues(t *testing.T) {
+	a := NewSafe()
+	b := a.Alloc(16)
+	if len(b) != 16 {
+		t.Fatalf("Alloc(16) returned len %d", len(b))
+	}
+	p := AllocValueSafe[int](a)
+	*p = 42
+	if *p != 42 {
+		t.Fatalf("AllocValueSafe roundtrip failed")
+	}
+}
+
+func TestSafeArenaAllocAfterFreePanics(t *testing.T) {
+	a := NewSafe()
+	a.Free()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic allocating from a freed SafeArena")
+		}
+	}()
+	a.Alloc(1)
+}
+
+func TestSafeArenaRefAfterFreePanics(t *testing.T) {
+	a := NewSafe()
+	ref := a.Ref()
+	a.Free()
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic allocating through an ArenaRef after Free")
+		}
+	}()
+	ref.Alloc(1)
+}
+
+func TestSafeArenaCollectRespectsGrace(t *testing.T) {
+	a := NewSafe(WithQuarantineGrace(1))
+	a.Alloc(8)
+	a.Free()
+	if len(a.quarantine) != 1 {
+		t.Fatalf("expected 1 quarantined chunk after Free, got %d", len(a.quarantine))
+	}
+	a.Collect()
+	if len(a.quarantine) != 1 {
+		t.Fatalf("grace=1 should survive the first Collect, got %d left", len(a.quarantine))
+	}
+	a.Collect()
+	if len(a.quarantine) != 0 {
+		t.Fatalf("expected quarantine drained after second Collect, got %d left", len(a.quarantine))
+	}
+}
+
+// TestSafeArenaFaultsAfterFree verifies that a pointer into memory freed by
+// SafeArena.Free segfaults instead of silently reading/writing, by
+// re-executing this test binary in a subprocess and checking it died from
+// a fault rather than exiting cleanly.
+func TestSafeArenaFaultsAfterFree(t *testing.T) {
+	if os.Getenv("ARENA_CRASH_SUBPROCESS") == "1" {
+		a := NewSafe()
+		b := a.Alloc(8)
+		a.Free()
+		b[0] = 1 // must fault: the page backing b is now PROT_NONE
+		t.Fatal("write after Free did not fault")
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestSafeArenaFaultsAfterFree")
+	cmd.Env = append(os.Environ(), "ARENA_CRASH_SUBPROCESS=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatal("expected subprocess to crash on use-after-free, it exited cleanly")
+	}
+	if _, ok := err.(*exec.ExitError); !ok {
+		t.Fatalf("expected an ExitError from the crashing subprocess, got %v (%T)", err, err)
+	}
+	if strings.Contains(string(out), "write after Free did not fault") {
+		t.Fatalf("subprocess failed without faulting on use-after-free:\n%s", out)
+	}
+}
@@ -0,0 +1,26 @@
+//go:build !windows
+
+package arena
+
+import "syscall"
+
+// mmapAnon allocates n bytes of anonymous, private memory via mmap.
+func mmapAnon(n int) ([]byte, error) {
+	return syscall.Mmap(-1, 0, n, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+}
+
+// protectNone marks b inaccessible; any read or write to it faults.
+func protectNone(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	return syscall.Mprotect(b, syscall.PROT_NONE)
+}
+
+// munmapRegion returns b's address range to the OS.
+func munmapRegion(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	return syscall.Munmap(b)
+}
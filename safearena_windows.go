@@ -0,0 +1,57 @@
+//go:build windows
+
+package arena
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+var (
+	kernel32           = syscall.NewLazyDLL("kernel32.dll")
+	procVirtualAlloc   = kernel32.NewProc("VirtualAlloc")
+	procVirtualProtect = kernel32.NewProc("VirtualProtect")
+	procVirtualFree    = kernel32.NewProc("VirtualFree")
+)
+
+const (
+	memCommit     = 0x00001000
+	memReserve    = 0x00002000
+	memRelease    = 0x00008000
+	pageReadwrite = 0x04
+	pageNoAccess  = 0x01
+)
+
+// mmapAnon allocates n bytes of committed, reserved memory via VirtualAlloc.
+func mmapAnon(n int) ([]byte, error) {
+	addr, _, err := procVirtualAlloc.Call(0, uintptr(n), memCommit|memReserve, pageReadwrite)
+	if addr == 0 {
+		return nil, err
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(addr)), n), nil
+}
+
+// protectNone marks b inaccessible; any read or write to it faults.
+func protectNone(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	var old uint32
+	ok, _, err := procVirtualProtect.Call(uintptr(unsafe.Pointer(&b[0])), uintptr(len(b)), pageNoAccess, uintptr(unsafe.Pointer(&old)))
+	if ok == 0 {
+		return err
+	}
+	return nil
+}
+
+// munmapRegion returns b's address range to the OS.
+func munmapRegion(b []byte) error {
+	if len(b) == 0 {
+		return nil
+	}
+	ok, _, err := procVirtualFree.Call(uintptr(unsafe.Pointer(&b[0])), 0, memRelease)
+	if ok == 0 {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package arena
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// AllocSlice allocates a slice of length l and capacity c out of a,
+// returning a []T backed by arena memory. T must not contain Go pointers
+// (mirrors AllocValue's restriction); AllocSlice panics otherwise.
+func AllocSlice[T any](a *Arena, l int, c int) []T {
+	if l < 0 || c < l {
+		panic("arena: AllocSlice called with invalid len/cap")
+	}
+	var zeroT *T
+	typ := reflect.TypeOf(zeroT).Elem()
+	if containsPointers(typ) {
+		panic("arena: AllocSlice called for a type that contains Go pointers")
+	}
+	if c == 0 {
+		return make([]T, 0)
+	}
+	sz := int(typ.Size())
+	if sz == 0 {
+		// zero-sized element type: no storage needed, just a non-nil pointer
+		// to back the slice header (mirrors AllocValue's sz == 0 case).
+		b := a.Alloc(1)
+		s := unsafe.Slice((*T)(unsafe.Pointer(&b[0])), c)
+		return s[:l]
+	}
+	mem := a.AllocAligned(sz*c, typ.Align())
+	s := unsafe.Slice((*T)(unsafe.Pointer(&mem[0])), c)
+	return s[:l]
+}
+
+// AppendSlice appends vs to s, growing into a when s has no spare capacity.
+// If s ends exactly at a's current bump offset (i.e. s was the most recent
+// allocation out of a and nothing has been allocated since), AppendSlice
+// extends it in place by bumping a's offset, avoiding a copy; otherwise it
+// allocates a fresh, larger slice from a and copies s into it, as append
+// does for the Go heap. T must not contain Go pointers.
+func AppendSlice[T any](a *Arena, s []T, vs ...T) []T {
+	if len(vs) == 0 {
+		return s
+	}
+	var zeroT *T
+	typ := reflect.TypeOf(zeroT).Elem()
+	if containsPointers(typ) {
+		panic("arena: AppendSlice called for a type that contains Go pointers")
+	}
+	sz := int(typ.Size())
+
+	if cap(s)-len(s) >= len(vs) {
+		s = s[:len(s)+len(vs)]
+		copy(s[len(s)-len(vs):], vs)
+		return s
+	}
+
+	if n := len(s); n > 0 && sz > 0 {
+		tail := unsafe.Pointer(uintptr(unsafe.Pointer(&s[0])) + uintptr(n)*uintptr(sz))
+		last := a.chunks[len(a.chunks)-1]
+		lastEnd := unsafe.Pointer(&last[0])
+		if uintptr(tail) == uintptr(lastEnd)+uintptr(a.off) {
+			need := len(vs) * sz
+			if a.off+need <= len(last) {
+				grown := a.AllocAligned(need, 1)
+				_ = grown // space is contiguous with s; just extend the slice below
+				out := unsafe.Slice((*T)(unsafe.Pointer(&s[0])), n+len(vs))
+				copy(out[n:], vs)
+				return out
+			}
+		}
+	}
+
+	newCap := cap(s)*2 + len(vs)
+	if newCap < len(s)+len(vs) {
+		newCap = len(s) + len(vs)
+	}
+	out := AllocSlice[T](a, len(s)+len(vs), newCap)
+	copy(out, s)
+	copy(out[len(s):], vs)
+	return out
+}
+
+// AllocString copies b into arena memory owned by a and returns a string
+// header pointing at it. The returned string is only valid for as long as
+// a (and the chunk it was allocated from) is alive and not Reset/Released.
+func AllocString(a *Arena, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	mem := a.Alloc(len(b))
+	copy(mem, b)
+	return unsafe.String(&mem[0], len(mem))
+}
@@ -0,0 +1,64 @@
+package arena
+
+import "testing"
+
+func TestAllocSlice(t *testing.T) {
+	a := New()
+	s := AllocSlice[int](a, 3, 5)
+	if len(s) != 3 || cap(s) != 5 {
+		t.Fatalf("AllocSlice len/cap = %d/%d, want 3/5", len(s), cap(s))
+	}
+}
+
+func TestAllocSliceZeroSizedElem(t *testing.T) {
+	a := New()
+	s := AllocSlice[struct{}](a, 3, 3)
+	if len(s) != 3 {
+		t.Fatalf("AllocSlice of struct{} len = %d, want 3", len(s))
+	}
+}
+
+func TestAllocSliceInterfaceElemPanicsCleanly(t *testing.T) {
+	a := New()
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("expected panic for an interface element type")
+		}
+		if msg, ok := r.(string); !ok || msg != "arena: AllocSlice called for a type that contains Go pointers" {
+			t.Fatalf("expected the documented panic message, got %v", r)
+		}
+	}()
+	AllocSlice[any](a, 2, 2)
+}
+
+func TestAppendSliceGrowsInPlace(t *testing.T) {
+	a := New()
+	s := AllocSlice[int](a, 0, 4)
+	s = AppendSlice(a, s, 1, 2)
+	if len(s) != 2 || s[0] != 1 || s[1] != 2 {
+		t.Fatalf("AppendSlice result = %v, want [1 2]", s)
+	}
+	s = AppendSlice(a, s, 3, 4)
+	if len(s) != 4 || s[2] != 3 || s[3] != 4 {
+		t.Fatalf("AppendSlice result = %v, want [1 2 3 4]", s)
+	}
+}
+
+func TestAppendSliceGrowsBeyondCapacity(t *testing.T) {
+	a := New()
+	s := AllocSlice[int](a, 2, 2)
+	s[0], s[1] = 1, 2
+	s = AppendSlice(a, s, 3)
+	if len(s) != 3 || s[0] != 1 || s[1] != 2 || s[2] != 3 {
+		t.Fatalf("AppendSlice result = %v, want [1 2 3]", s)
+	}
+}
+
+func TestAllocString(t *testing.T) {
+	a := New()
+	s := AllocString(a, []byte("hello"))
+	if s != "hello" {
+		t.Fatalf("AllocString = %q, want %q", s, "hello")
+	}
+}
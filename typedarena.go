@@ -0,0 +1,83 @@
+package arena
+
+// TypedArena allocates values of a single type T out of growable chunks.
+// Unlike AllocValue, T may freely contain Go pointers (slices, strings,
+// maps, interfaces): chunks are kept as ordinary []T slices rather than
+// raw bytes, so the garbage collector traces pointers stored inside
+// arena-allocated values exactly as it would for any other []T. This
+// trades the byte-packing of Arena for that safety, and mirrors Rust's
+// single-type TypedArena.
+type TypedArena[T any] struct {
+	chunkLen int
+	chunks   [][]T
+	off      int
+}
+
+// NewTypedArena creates a TypedArena holding chunkLen elements per chunk.
+// A chunkLen <= 0 selects a default of 256.
+func NewTypedArena[T any](chunkLen int) *TypedArena[T] {
+	if chunkLen <= 0 {
+		chunkLen = 256
+	}
+	t := &TypedArena[T]{chunkLen: chunkLen}
+	t.chunks = append(t.chunks, make([]T, chunkLen))
+	return t
+}
+
+// New allocates a zero-valued T and returns a pointer to it.
+func (t *TypedArena[T]) New() *T {
+	last := t.chunks[len(t.chunks)-1]
+	if t.off == len(last) {
+		last = make([]T, t.chunkLen)
+		t.chunks = append(t.chunks, last)
+		t.off = 0
+	}
+	p := &last[t.off]
+	t.off++
+	return p
+}
+
+// Alloc allocates a T inside the arena initialized to v and returns a
+// pointer to it.
+func (t *TypedArena[T]) Alloc(v T) *T {
+	p := t.New()
+	*p = v
+	return p
+}
+
+// Release runs drop (if non-nil) over every live entry across all chunks,
+// in allocation order, then reclaims the arena's chunks. Release leaves
+// the arena ready to allocate again, starting from a single fresh chunk.
+func (t *TypedArena[T]) Release(drop func(*T)) {
+	if drop != nil {
+		for i, chunk := range t.chunks {
+			n := len(chunk)
+			if i == len(t.chunks)-1 {
+				n = t.off
+			}
+			for j := 0; j < n; j++ {
+				drop(&chunk[j])
+			}
+		}
+	}
+	for i := 1; i < len(t.chunks); i++ {
+		t.chunks[i] = nil
+	}
+	t.chunks = t.chunks[:1]
+	t.chunks[0] = make([]T, t.chunkLen)
+	t.off = 0
+}
+
+// Reset is Release(nil): it discards every allocated value without
+// running a destructor over them.
+func (t *TypedArena[T]) Reset() {
+	t.Release(nil)
+}
+
+// Len reports the number of live values currently allocated.
+func (t *TypedArena[T]) Len() int {
+	if len(t.chunks) == 0 {
+		return 0
+	}
+	return (len(t.chunks)-1)*t.chunkLen + t.off
+}
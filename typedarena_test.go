@@ -0,0 +1,80 @@
+package arena
+
+import "testing"
+
+func TestTypedArenaAllocAndNew(t *testing.T) {
+	type pair struct {
+		s string
+		n int
+	}
+	ta := NewTypedArena[pair](4)
+	p1 := ta.Alloc(pair{s: "a", n: 1})
+	p2 := ta.New()
+	p2.s = "b"
+	p2.n = 2
+
+	if p1.s != "a" || p1.n != 1 {
+		t.Fatalf("Alloc roundtrip failed: %+v", *p1)
+	}
+	if ta.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", ta.Len())
+	}
+}
+
+func TestTypedArenaGrowsAcrossChunks(t *testing.T) {
+	ta := NewTypedArena[int](2)
+	for i := 0; i < 5; i++ {
+		ta.Alloc(i)
+	}
+	if got := ta.Len(); got != 5 {
+		t.Fatalf("Len() = %d, want 5", got)
+	}
+	if len(ta.chunks) < 3 {
+		t.Fatalf("expected arena to have grown past 2 chunks of len 2, got %d chunks", len(ta.chunks))
+	}
+}
+
+func TestTypedArenaReleaseRunsDrop(t *testing.T) {
+	ta := NewTypedArena[int](4)
+	ta.Alloc(1)
+	ta.Alloc(2)
+	ta.Alloc(3)
+
+	var dropped []int
+	ta.Release(func(p *int) { dropped = append(dropped, *p) })
+
+	if len(dropped) != 3 {
+		t.Fatalf("Release ran drop %d times, want 3", len(dropped))
+	}
+	if ta.Len() != 0 {
+		t.Fatalf("Len() after Release = %d, want 0", ta.Len())
+	}
+}
+
+func TestTypedArenaReleaseNilsDroppedChunks(t *testing.T) {
+	ta := NewTypedArena[int](2)
+	for i := 0; i < 5; i++ {
+		ta.Alloc(i)
+	}
+	chunks := ta.chunks
+	if len(chunks) < 3 {
+		t.Fatalf("expected at least 3 chunks before Release, got %d", len(chunks))
+	}
+
+	ta.Release(nil)
+
+	for i := 1; i < len(chunks); i++ {
+		if chunks[i] != nil {
+			t.Fatalf("chunk %d in the old backing array was not nil'd by Release, still retains %d elements", i, len(chunks[i]))
+		}
+	}
+}
+
+func TestTypedArenaResetSkipsDrop(t *testing.T) {
+	ta := NewTypedArena[int](4)
+	ta.Alloc(1)
+	ta.Reset()
+	if ta.Len() != 0 {
+		t.Fatalf("Len() after Reset = %d, want 0", ta.Len())
+	}
+}